@@ -0,0 +1,117 @@
+package ingestion
+
+// Candidate is a candidate as returned by the partner ingestion API.
+type Candidate struct {
+	ID           int64         `json:"id"`
+	Name         string        `json:"name"`
+	ExternalID   string        `json:"external_id"`
+	Applications []Application `json:"applications"`
+}
+
+// Application is a candidate's application to a job.
+type Application struct {
+	ID         int64  `json:"id"`
+	Job        string `json:"job"`
+	Status     string `json:"status"`
+	Stage      string `json:"stage"`
+	ProfileURL string `json:"profile_url"`
+}
+
+// PhoneNumberType identifies the kind of phone number on a PostCandidate.
+type PhoneNumberType string
+
+const (
+	PhoneNumberTypeHome   PhoneNumberType = "home"
+	PhoneNumberTypeWork   PhoneNumberType = "work"
+	PhoneNumberTypeMobile PhoneNumberType = "mobile"
+	PhoneNumberTypeOther  PhoneNumberType = "other"
+)
+
+// PhoneNumber is a candidate phone number.
+type PhoneNumber struct {
+	PhoneNumber string          `json:"phone_number"`
+	Type        PhoneNumberType `json:"type"`
+}
+
+// EmailType identifies the kind of email address on a PostCandidate.
+type EmailType string
+
+const (
+	EmailTypePersonal EmailType = "personal"
+	EmailTypeWork     EmailType = "work"
+	EmailTypeOther    EmailType = "other"
+)
+
+// Email is a candidate email address.
+type Email struct {
+	Email string    `json:"email"`
+	Type  EmailType `json:"type"`
+}
+
+// SocialMedia is a link to a candidate's social media profile.
+type SocialMedia struct {
+	URL string `json:"url"`
+}
+
+// WebsiteType identifies the kind of website on a PostCandidate.
+type WebsiteType string
+
+const (
+	WebsiteTypePersonal  WebsiteType = "personal"
+	WebsiteTypeCompany   WebsiteType = "company"
+	WebsiteTypePortfolio WebsiteType = "portfolio"
+	WebsiteTypeBlog      WebsiteType = "blog"
+	WebsiteTypeOther     WebsiteType = "other"
+)
+
+// Website is a link to a candidate's website.
+type Website struct {
+	URL  string      `json:"url"`
+	Type WebsiteType `json:"type"`
+}
+
+// AddressType identifies the kind of address on a PostCandidate.
+type AddressType string
+
+const (
+	AddressTypeHome  AddressType = "home"
+	AddressTypeWork  AddressType = "work"
+	AddressTypeOther AddressType = "other"
+)
+
+// Address is a candidate postal address.
+type Address struct {
+	Address string      `json:"address"`
+	Type    AddressType `json:"type"`
+}
+
+// PostCandidate is the payload used to create or update a candidate via
+// CandidateService.Post.
+type PostCandidate struct {
+	Prospect            bool          `json:"prospect"`
+	FirstName           string        `json:"first_name"`
+	LastName            string        `json:"last_name"`
+	Company             string        `json:"company,omitempty"`
+	Title               string        `json:"title,omitempty"`
+	Resume              string        `json:"resume,omitempty"`
+	PhoneNumbers        []PhoneNumber `json:"phone_numbers,omitempty"`
+	Emails              []Email       `json:"emails,omitempty"`
+	SocialMedia         []SocialMedia `json:"social_media,omitempty"`
+	Websites            []Website     `json:"websites,omitempty"`
+	Addresses           []Address     `json:"addresses,omitempty"`
+	JobID               int64         `json:"job_id,omitempty"`
+	ExternalID          string        `json:"external_id,omitempty"`
+	Notes               string        `json:"notes,omitempty"`
+	ProspectPoolID      int64         `json:"prospect_pool_id,omitempty"`
+	ProspectPoolStageID int64         `json:"prospect_pool_stage_id,omitempty"`
+	ProspectOwnerEmail  string        `json:"prospect_owner_email,omitempty"`
+}
+
+// PostCandidateResponse is returned by CandidateService.Post for each
+// candidate submitted.
+type PostCandidateResponse struct {
+	ID            int64  `json:"id"`
+	ApplicationID int64  `json:"application_id"`
+	ExternalID    string `json:"external_id"`
+	ProfileURL    string `json:"profile_url"`
+}
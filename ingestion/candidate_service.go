@@ -0,0 +1,173 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// CandidateService handles communication with the candidate-related
+// endpoints of the partner ingestion API.
+type CandidateService struct {
+	client *Client
+}
+
+// Retrieve fetches the candidates identified by ids, following pagination
+// until the list is exhausted. It is equivalent to RetrieveContext with
+// context.Background.
+func (s *CandidateService) Retrieve(ids []int64) ([]Candidate, error) {
+	return s.RetrieveContext(context.Background(), ids)
+}
+
+// RetrieveContext fetches the candidates identified by ids, following
+// pagination until the list is exhausted.
+func (s *CandidateService) RetrieveContext(ctx context.Context, ids []int64) ([]Candidate, error) {
+	it := s.ListContext(ctx, ids)
+	defer it.Close()
+
+	var candidates []Candidate
+	for it.Next(ctx) {
+		candidates = append(candidates, it.Value())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return candidates, nil
+}
+
+// Post creates or updates the given candidates. It is equivalent to
+// PostContext with context.Background.
+func (s *CandidateService) Post(candidates []PostCandidate) ([]PostCandidateResponse, error) {
+	return s.PostContext(context.Background(), candidates)
+}
+
+// PostContext creates or updates the given candidates.
+func (s *CandidateService) PostContext(ctx context.Context, candidates []PostCandidate) ([]PostCandidateResponse, error) {
+	req, err := s.client.newRequestWithContext(ctx, "POST", "partner/candidates", candidates, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []PostCandidateResponse
+	if err := s.client.do(req, &responses); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// CandidateIteratorOption configures a CandidateIterator returned by List
+// or ListContext.
+type CandidateIteratorOption func(*CandidateIterator)
+
+// WithPageSize sets the page size query param used when fetching each
+// page of results.
+func WithPageSize(n int) CandidateIteratorOption {
+	return func(it *CandidateIterator) {
+		it.pageSize = n
+	}
+}
+
+// List returns an iterator over the candidates identified by ids,
+// transparently following the API's rel="next" pagination. It is
+// equivalent to ListContext with context.Background.
+func (s *CandidateService) List(ids []int64, opts ...CandidateIteratorOption) *CandidateIterator {
+	return s.ListContext(context.Background(), ids, opts...)
+}
+
+// ListContext returns an iterator over the candidates identified by ids,
+// transparently following the API's rel="next" pagination.
+func (s *CandidateService) ListContext(ctx context.Context, ids []int64, opts ...CandidateIteratorOption) *CandidateIterator {
+	it := &CandidateIterator{client: s.client}
+	for _, opt := range opts {
+		opt(it)
+	}
+
+	urlStr := "partner/candidates?ids=" + url.QueryEscape(interfaceToCSV(ids))
+	if it.pageSize > 0 {
+		urlStr += fmt.Sprintf("&per_page=%d", it.pageSize)
+	}
+	it.nextURL = urlStr
+
+	return it
+}
+
+// CandidateIterator iterates over a paginated list of candidates, fetching
+// each subsequent page lazily from the previous response's
+// Link: <...>; rel="next" header.
+type CandidateIterator struct {
+	client   *Client
+	pageSize int
+
+	nextURL string // URL of the next page to fetch; "" once exhausted
+	done    bool
+
+	items []Candidate
+	idx   int
+	cur   Candidate
+	err   error
+}
+
+// Next advances the iterator and reports whether a Value is available. It
+// fetches the next page of results on demand, and returns false once the
+// list is exhausted or an error occurs (check Err to distinguish the two).
+func (it *CandidateIterator) Next(ctx context.Context) bool {
+	for !it.done && it.idx >= len(it.items) {
+		if it.nextURL == "" {
+			it.done = true
+			return false
+		}
+		if err := it.fetchPage(ctx); err != nil {
+			it.err = err
+			it.done = true
+			return false
+		}
+	}
+	if it.done {
+		return false
+	}
+
+	it.cur = it.items[it.idx]
+	it.idx++
+	return true
+}
+
+// Value returns the candidate most recently advanced to by Next.
+func (it *CandidateIterator) Value() Candidate {
+	return it.cur
+}
+
+// Err returns the first error encountered by Next, if any.
+func (it *CandidateIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator. Further calls to Next will return false.
+func (it *CandidateIterator) Close() {
+	it.done = true
+	it.items = nil
+}
+
+func (it *CandidateIterator) fetchPage(ctx context.Context) error {
+	req, err := it.client.newRequestWithContext(ctx, "GET", it.nextURL, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	body, header, err := it.client.doRaw(req)
+	if err != nil {
+		return err
+	}
+
+	var items []Candidate
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &items); err != nil {
+			return err
+		}
+	}
+
+	it.items = items
+	it.idx = 0
+	it.nextURL = parseLinkHeader(header.Get("Link"))["next"]
+	return nil
+}
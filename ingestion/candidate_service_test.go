@@ -1,6 +1,8 @@
 package ingestion
 
 import (
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
@@ -183,3 +185,108 @@ func Test_candidateService_Post(t *testing.T) {
 	assert.Equal(t, test.wantCandidates, gotCandidates)
 
 }
+
+func Test_candidateService_contextCancellation(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{
+			name: "RetrieveContext",
+			call: func(ctx context.Context) error {
+				_, err := client.Candidates.RetrieveContext(ctx, []int64{12})
+				return err
+			},
+		},
+		{
+			name: "PostContext",
+			call: func(ctx context.Context) error {
+				_, err := client.Candidates.PostContext(ctx, []PostCandidate{{FirstName: "Harry"}})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup()
+			defer teardown()
+
+			started := make(chan struct{})
+			release := make(chan struct{})
+			mux.HandleFunc("/v1/partner/candidates", func(w http.ResponseWriter, r *http.Request) {
+				close(started)
+				<-release
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				<-started
+				cancel()
+				close(release)
+			}()
+
+			err := tt.call(ctx)
+			assert.Error(t, err)
+			assert.True(t, errors.Is(err, context.Canceled))
+
+			var clientErr ClientError
+			assert.False(t, errors.As(err, &clientErr))
+			var serverErr ServerError
+			assert.False(t, errors.As(err, &serverErr))
+		})
+	}
+}
+
+func Test_candidateService_List_followsPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requestedPages []string
+	mux.HandleFunc("/v1/partner/candidates", func(w http.ResponseWriter, r *http.Request) {
+		requestedPages = append(requestedPages, r.URL.RawQuery)
+		switch r.URL.Query().Get("page") {
+		case "", "1":
+			w.Header().Set("Link", `<`+server.URL+`/v1/partner/candidates?ids=12&page=2>; rel="next"`)
+			w.WriteHeader(200)
+			io.WriteString(w, `[{"id":1,"name":"Harry Potter"},{"id":2,"name":"Ron Weasley"}]`)
+		case "2":
+			w.WriteHeader(200)
+			io.WriteString(w, `[{"id":3,"name":"Hermione Granger"}]`)
+		default:
+			t.Fatalf("unexpected page: %s", r.URL.Query().Get("page"))
+		}
+	})
+
+	it := client.Candidates.List([]int64{12})
+	defer it.Close()
+
+	var names []string
+	for it.Next(context.Background()) {
+		names = append(names, it.Value().Name)
+	}
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []string{"Harry Potter", "Ron Weasley", "Hermione Granger"}, names)
+	assert.Len(t, requestedPages, 2, "should follow the Link header across exactly two HTTP round trips")
+}
+
+func Test_candidateService_List_surfacesServerError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/partner/candidates", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+		io.WriteString(w, `{"errors":[{"message":"boom"}]}`)
+	})
+
+	client = NewClient("access_token", nil, WithRetryPolicy(RetryPolicy{MaxRetries: 0}))
+	client.baseURL = server.URL + "/"
+
+	it := client.Candidates.List([]int64{12})
+	defer it.Close()
+
+	assert.False(t, it.Next(context.Background()))
+	serverErr, ok := IsServerError(it.Err())
+	assert.True(t, ok)
+	assert.Equal(t, 500, serverErr.StatusCode)
+}
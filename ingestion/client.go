@@ -0,0 +1,324 @@
+// Package ingestion provides a client for Greenhouse's partner ingestion API,
+// used to sync candidates and applications from an external ATS or sourcing
+// tool into Greenhouse.
+package ingestion
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBaseURL   = "https://api.greenhouse.io/"
+	apiVersion       = "v1"
+	defaultUserAgent = "greenhouse-go-client"
+)
+
+// Client manages communication with the Greenhouse partner ingestion API.
+type Client struct {
+	client *http.Client
+
+	baseURL   string
+	userAgent string
+
+	// token is set when the client authenticates with a bearer token.
+	token string
+
+	// apiKey/onBehalfOf are set when the client authenticates with the
+	// legacy basic-auth scheme.
+	apiKey     string
+	onBehalfOf string
+
+	// oauthConfig/oauthToken are set when the client authenticates via the
+	// OAuth2 authorization code flow; see NewClientOAuth.
+	oauthConfig    *OAuthConfig
+	oauthToken     *Token
+	oauthMu        sync.Mutex
+	onTokenRefresh func(*Token)
+
+	retryPolicy RetryPolicy
+	clock       Clock
+	sleeper     Sleeper
+
+	Candidates *CandidateService
+}
+
+// ClientOption configures optional Client behavior.
+type ClientOption func(*Client)
+
+// NewClient returns a Client that authenticates with httpClient using the
+// given OAuth2/bearer access token. If httpClient is nil, http.DefaultClient
+// is used.
+func NewClient(token string, httpClient *http.Client, opts ...ClientOption) *Client {
+	c := newClient(httpClient)
+	c.token = token
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewClientBasicAuth returns a Client that authenticates using the legacy
+// API key basic-auth scheme. onBehalfOf identifies the Greenhouse user the
+// requests are made on behalf of, and is sent via the On-Behalf-Of header.
+func NewClientBasicAuth(apiKey, onBehalfOf string, httpClient *http.Client, opts ...ClientOption) *Client {
+	c := newClient(httpClient)
+	c.apiKey = apiKey
+	c.onBehalfOf = onBehalfOf
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewClientOAuth returns a Client that authenticates via the OAuth2
+// authorization code flow described by cfg, starting from the already
+// obtained tok. The access token is transparently refreshed using the
+// refresh_token grant when it is near expiry or when a request comes back
+// 401; use WithTokenRefreshFunc to be notified so the rotated token can be
+// persisted.
+func NewClientOAuth(cfg *OAuthConfig, tok *Token, httpClient *http.Client, opts ...ClientOption) *Client {
+	c := newClient(httpClient)
+	c.oauthConfig = cfg
+	c.oauthToken = tok
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithTokenRefreshFunc registers a callback invoked whenever an OAuth
+// client rotates its access token, so the caller can persist the new
+// Token (e.g. back to their own datastore).
+func WithTokenRefreshFunc(f func(*Token)) ClientOption {
+	return func(c *Client) {
+		c.onTokenRefresh = f
+	}
+}
+
+func newClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	c := &Client{
+		client:      httpClient,
+		baseURL:     defaultBaseURL,
+		userAgent:   defaultUserAgent,
+		retryPolicy: DefaultRetryPolicy,
+		clock:       realClock{},
+		sleeper:     realSleeper{},
+	}
+	c.Candidates = &CandidateService{client: c}
+	return c
+}
+
+// newRequest builds an API request against urlStr using context.Background.
+// See newRequestWithContext.
+func (c *Client) newRequest(method, urlStr string, body interface{}, header http.Header) (*http.Request, error) {
+	return c.newRequestWithContext(context.Background(), method, urlStr, body, header)
+}
+
+// newRequestWithContext builds an API request against urlStr. A relative
+// urlStr (e.g. "partner/candidates") is taken relative to the API version
+// root ("{baseURL}v1/partner/candidates"); an absolute http(s) URL, such as
+// the rel="next" link an iterator follows across pages, is used as-is. If
+// body is non-nil it is JSON-encoded as the request body. Any headers set
+// in header are merged in after the client's own headers, so callers can
+// override them. ctx is attached to the request so that Client.do honors
+// cancellation and deadlines.
+func (c *Client) newRequestWithContext(ctx context.Context, method, urlStr string, body interface{}, header http.Header) (*http.Request, error) {
+	resolved := urlStr
+	if !strings.HasPrefix(urlStr, "http://") && !strings.HasPrefix(urlStr, "https://") {
+		resolved = c.baseURL + apiVersion + "/" + strings.TrimPrefix(urlStr, "/")
+	}
+
+	u, err := url.Parse(resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		buf = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	switch {
+	case c.oauthConfig != nil:
+		if err := c.ensureFreshToken(context.Background()); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+c.oauthToken.AccessToken)
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.apiKey != "":
+		req.Header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(c.apiKey))+":")
+		req.Header.Set("On-Behalf-Of", c.onBehalfOf)
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	return req, nil
+}
+
+// do sends req and, on success, JSON-decodes the response body into v (if
+// v is non-nil). Transient failures are retried according to the client's
+// RetryPolicy; see WithRetryPolicy. A 4xx response is returned as a
+// ClientError (a 429 only once its retries are exhausted), and a 5xx
+// response as a ServerError.
+func (c *Client) do(req *http.Request, v interface{}) error {
+	body, _, err := c.doRaw(req)
+	if err != nil {
+		return err
+	}
+	if v != nil && len(body) > 0 {
+		return json.Unmarshal(body, v)
+	}
+	return nil
+}
+
+// doRaw is do's implementation, returning the raw response body and
+// headers instead of decoding them. It's used directly by callers that
+// need response headers, such as CandidateIterator following a Link
+// header.
+func (c *Client) doRaw(req *http.Request) ([]byte, http.Header, error) {
+	reqBody, err := drainRequestBody(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policy := c.retryPolicy
+	interval := policy.InitialInterval
+	start := c.clock.Now()
+	oauthRetried := false
+
+	for attempt := 0; ; attempt++ {
+		if reqBody != nil {
+			req.Body = io.NopCloser(bytes.NewReader(reqBody))
+			req.ContentLength = int64(len(reqBody))
+		}
+
+		resp, err := c.client.Do(req)
+		if err != nil {
+			if ctxErr := req.Context().Err(); ctxErr != nil {
+				return nil, nil, ctxErr
+			}
+			if !c.withinBudget(attempt, policy, start) {
+				return nil, nil, err
+			}
+			c.sleeper.Sleep(nextBackoff(&interval, policy))
+			continue
+		}
+
+		respBody, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			return nil, nil, readErr
+		}
+
+		if resp.StatusCode == http.StatusUnauthorized && c.oauthConfig != nil && !oauthRetried {
+			oauthRetried = true
+			if refreshErr := c.forceRefreshToken(req.Context()); refreshErr == nil {
+				req.Header.Set("Authorization", "Bearer "+c.oauthToken.AccessToken)
+				continue
+			}
+		}
+
+		if resp.StatusCode < 400 {
+			return respBody, resp.Header, nil
+		}
+
+		if !isRetriableStatus(resp.StatusCode) || !c.withinBudget(attempt, policy, start) {
+			return nil, nil, decodeAPIError(resp.StatusCode, respBody)
+		}
+
+		if wait := retryAfter(resp.Header); wait > 0 {
+			c.sleeper.Sleep(wait)
+		} else {
+			c.sleeper.Sleep(nextBackoff(&interval, policy))
+		}
+	}
+}
+
+// drainRequestBody reads req.Body (if any) into memory so that it can be
+// replayed across retry attempts, since http.Request bodies can only be
+// read once.
+func drainRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return io.ReadAll(req.Body)
+}
+
+func (c *Client) withinBudget(attempt int, policy RetryPolicy, start time.Time) bool {
+	if attempt >= policy.MaxRetries {
+		return false
+	}
+	if policy.MaxElapsedTime > 0 && c.clock.Now().Sub(start) >= policy.MaxElapsedTime {
+		return false
+	}
+	return true
+}
+
+// ensureFreshToken refreshes the client's OAuth token if it's within
+// tokenRefreshSkew of expiring. A missing token is left as it is.
+func (c *Client) ensureFreshToken(ctx context.Context) error {
+	c.oauthMu.Lock()
+	defer c.oauthMu.Unlock()
+
+	if c.oauthToken == nil || !c.oauthToken.expired() {
+		return nil
+	}
+	return c.refreshTokenLocked(ctx)
+}
+
+// forceRefreshToken refreshes the client's OAuth token unconditionally,
+// used to recover from a 401 that a still-valid-looking token nonetheless
+// triggered.
+func (c *Client) forceRefreshToken(ctx context.Context) error {
+	c.oauthMu.Lock()
+	defer c.oauthMu.Unlock()
+	return c.refreshTokenLocked(ctx)
+}
+
+// refreshTokenLocked performs the refresh_token grant and notifies
+// onTokenRefresh. Callers must hold c.oauthMu.
+func (c *Client) refreshTokenLocked(ctx context.Context) error {
+	if c.oauthToken == nil {
+		return nil
+	}
+
+	tok, err := c.oauthConfig.refresh(ctx, c.oauthToken.RefreshToken)
+	if err != nil {
+		return err
+	}
+	c.oauthToken = tok
+	if c.onTokenRefresh != nil {
+		c.onTokenRefresh(tok)
+	}
+	return nil
+}
@@ -8,10 +8,27 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// fakeSleeper records the durations it's asked to sleep without actually
+// sleeping, so retry tests run instantly and deterministically.
+type fakeSleeper struct {
+	sleeps []time.Duration
+}
+
+func (f *fakeSleeper) Sleep(d time.Duration) {
+	f.sleeps = append(f.sleeps, d)
+}
+
+// fakeClock never advances, so tests are governed by MaxRetries rather than
+// MaxElapsedTime.
+type fakeClock struct{}
+
+func (fakeClock) Now() time.Time { return time.Time{} }
+
 var (
 	// mux is the HTTP request multiplexer used with the test server
 	mux *http.ServeMux
@@ -122,10 +139,74 @@ func Test_spaceDelimit(t *testing.T) {
 		})
 	}
 }
+
+func Test_parseLinkHeader(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  map[string]string
+	}{
+		{
+			name:  "empty",
+			value: "",
+			want:  map[string]string{},
+		},
+		{
+			name:  "single rel",
+			value: `<https://api.greenhouse.io/v1/partner/candidates?page=2>; rel="next"`,
+			want:  map[string]string{"next": "https://api.greenhouse.io/v1/partner/candidates?page=2"},
+		},
+		{
+			name:  "multiple rels",
+			value: `<https://api.greenhouse.io/v1/partner/candidates?page=2>; rel="next", <https://api.greenhouse.io/v1/partner/candidates?page=5>; rel="last"`,
+			want: map[string]string{
+				"next": "https://api.greenhouse.io/v1/partner/candidates?page=2",
+				"last": "https://api.greenhouse.io/v1/partner/candidates?page=5",
+			},
+		},
+		{
+			name:  "unquoted rel",
+			value: `<https://api.greenhouse.io/v1/partner/candidates?page=2>; rel=next`,
+			want:  map[string]string{"next": "https://api.greenhouse.io/v1/partner/candidates?page=2"},
+		},
+		{
+			name:  "url with query params containing percent-encoded commas",
+			value: `<https://api.greenhouse.io/v1/partner/candidates?ids=1%2C2%2C3>; rel="next"`,
+			want:  map[string]string{"next": "https://api.greenhouse.io/v1/partner/candidates?ids=1%2C2%2C3"},
+		},
+		{
+			name:  "malformed entry is skipped",
+			value: `not-a-link-value, <https://api.greenhouse.io/v1/partner/candidates?page=2>; rel="next"`,
+			want:  map[string]string{"next": "https://api.greenhouse.io/v1/partner/candidates?page=2"},
+		},
+		{
+			name:  "missing angle brackets is skipped",
+			value: `https://api.greenhouse.io/v1/partner/candidates?page=2; rel="next"`,
+			want:  map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseLinkHeader(tt.value))
+		})
+	}
+}
+
 func Test_do_client_error(t *testing.T) {
 	setup()
 	defer teardown()
+
+	sleeper := &fakeSleeper{}
+	client = NewClient("access_token", nil,
+		WithRetryPolicy(RetryPolicy{InitialInterval: time.Millisecond, Multiplier: 2, MaxInterval: time.Second, MaxElapsedTime: time.Hour, MaxRetries: 3}),
+		WithSleeper(sleeper),
+		WithClock(fakeClock{}),
+	)
+	client.baseURL = server.URL + "/"
+
+	var calls int
 	mux.HandleFunc("/v1/client-error", func(w http.ResponseWriter, r *http.Request) {
+		calls++
 		if r.Method != "GET" {
 			t.Fatalf("unexpected method: %s", r.Method)
 		}
@@ -176,12 +257,26 @@ func Test_do_client_error(t *testing.T) {
 
 	clientError, _ := IsClientError(err)
 	assert.Equal(t, test.clientError, clientError)
+
+	assert.Equal(t, 1, calls, "a 400 must not be retried")
+	assert.Empty(t, sleeper.sleeps)
 }
 
 func Test_do_server_error(t *testing.T) {
 	setup()
 	defer teardown()
+
+	sleeper := &fakeSleeper{}
+	client = NewClient("access_token", nil,
+		WithRetryPolicy(RetryPolicy{InitialInterval: time.Millisecond, Multiplier: 2, MaxInterval: time.Second, MaxElapsedTime: time.Hour, MaxRetries: 3}),
+		WithSleeper(sleeper),
+		WithClock(fakeClock{}),
+	)
+	client.baseURL = server.URL + "/"
+
+	var calls int
 	mux.HandleFunc("/v1/server-error", func(w http.ResponseWriter, r *http.Request) {
+		calls++
 		if r.Method != "GET" {
 			t.Fatalf("unexpected method: %s", r.Method)
 		}
@@ -232,6 +327,9 @@ func Test_do_server_error(t *testing.T) {
 
 	serverError, _ := IsServerError(err)
 	assert.Equal(t, test.serverError, serverError)
+
+	assert.Equal(t, 4, calls, "expected the initial attempt plus MaxRetries retries")
+	assert.Len(t, sleeper.sleeps, 3)
 }
 
 func TestClient_newRequest_header_OAuth(t *testing.T) {
@@ -0,0 +1,66 @@
+package ingestion
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Error represents a single field-level error returned by the Greenhouse
+// API.
+type Error struct {
+	Message string `json:"message"`
+	Field   string `json:"field,omitempty"`
+}
+
+// errorsEnvelope is the shape of a Greenhouse API error response body.
+type errorsEnvelope struct {
+	Errors []Error `json:"errors"`
+}
+
+// ClientError is returned by Client.do when the API responds with a 4xx
+// status code; for a 429, only once the client has exhausted its retries.
+type ClientError struct {
+	StatusCode int
+	Errors     []Error
+}
+
+func (e ClientError) Error() string {
+	return fmt.Sprintf("greenhouse: client error (status %d): %v", e.StatusCode, e.Errors)
+}
+
+// ServerError is returned by Client.do when the API responds with a 5xx
+// status code and the client has exhausted its retries.
+type ServerError struct {
+	StatusCode int
+	Errors     []Error
+}
+
+func (e ServerError) Error() string {
+	return fmt.Sprintf("greenhouse: server error (status %d): %v", e.StatusCode, e.Errors)
+}
+
+// IsClientError reports whether err is a ClientError, returning the
+// unwrapped value for convenience.
+func IsClientError(err error) (ClientError, bool) {
+	ce, ok := err.(ClientError)
+	return ce, ok
+}
+
+// IsServerError reports whether err is a ServerError, returning the
+// unwrapped value for convenience.
+func IsServerError(err error) (ServerError, bool) {
+	se, ok := err.(ServerError)
+	return se, ok
+}
+
+// decodeAPIError builds the appropriate error type for a non-2xx response,
+// best-effort decoding the Greenhouse error envelope out of body.
+func decodeAPIError(statusCode int, body []byte) error {
+	var env errorsEnvelope
+	_ = json.Unmarshal(body, &env)
+
+	if statusCode >= 500 {
+		return ServerError{StatusCode: statusCode, Errors: env.Errors}
+	}
+	return ClientError{StatusCode: statusCode, Errors: env.Errors}
+}
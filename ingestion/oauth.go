@@ -0,0 +1,170 @@
+package ingestion
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultAuthorizeURL = "https://app.greenhouse.io/oauth/authorize"
+	defaultTokenURL     = "https://app.greenhouse.io/oauth/token"
+
+	// tokenRefreshSkew is how far ahead of a token's expiry Client starts
+	// treating it as due for a refresh.
+	tokenRefreshSkew = 60 * time.Second
+)
+
+// OAuthConfig describes a partner app registered with Greenhouse, used to
+// install the partner ingestion integration per customer via the
+// three-legged OAuth2 authorization code flow.
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+
+	// AuthorizeURL and TokenURL default to Greenhouse's OAuth endpoints;
+	// overriding them is only useful in tests.
+	AuthorizeURL string
+	TokenURL     string
+
+	// HTTPClient is used for the token exchange/refresh requests. Defaults
+	// to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// Token is an OAuth2 access token issued by Greenhouse, along with the
+// refresh token used to rotate it once it expires.
+type Token struct {
+	AccessToken  string
+	RefreshToken string
+	TokenType    string
+	Expiry       time.Time
+}
+
+// expired reports whether the token is within tokenRefreshSkew of expiring.
+// A nil token or one with no Expiry is never reported as expired.
+func (t *Token) expired() bool {
+	if t == nil || t.Expiry.IsZero() {
+		return false
+	}
+	return time.Until(t.Expiry) <= tokenRefreshSkew
+}
+
+// AuthCodeURL builds the URL to redirect a user to in order to begin the
+// authorization code flow. state is later returned unmodified to the
+// RedirectURL callback so it can be correlated back to this request.
+func (c *OAuthConfig) AuthCodeURL(state string) string {
+	v := url.Values{
+		"response_type": {"code"},
+		"client_id":     {c.ClientID},
+		"redirect_uri":  {c.RedirectURL},
+		"state":         {state},
+	}
+	if len(c.Scopes) > 0 {
+		v.Set("scope", spaceDelimit(c.Scopes))
+	}
+
+	return c.authorizeURL() + "?" + v.Encode()
+}
+
+// Exchange trades an authorization code for an access token and refresh
+// token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code string) (*Token, error) {
+	return c.requestToken(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+		"redirect_uri":  {c.RedirectURL},
+	})
+}
+
+// refresh trades a refresh token for a new access token.
+func (c *OAuthConfig) refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	tok, err := c.requestToken(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+		"client_id":     {c.ClientID},
+		"client_secret": {c.ClientSecret},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tok.RefreshToken == "" {
+		// Greenhouse doesn't always rotate the refresh token; keep the one
+		// we already have if the response omits it.
+		tok.RefreshToken = refreshToken
+	}
+	return tok, nil
+}
+
+func (c *OAuthConfig) requestToken(ctx context.Context, form url.Values) (*Token, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.tokenURL(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 400 {
+		return nil, decodeAPIError(resp.StatusCode, body)
+	}
+
+	var raw struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+		TokenType    string `json:"token_type"`
+		ExpiresIn    int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	tok := &Token{
+		AccessToken:  raw.AccessToken,
+		RefreshToken: raw.RefreshToken,
+		TokenType:    raw.TokenType,
+	}
+	if raw.ExpiresIn > 0 {
+		tok.Expiry = time.Now().Add(time.Duration(raw.ExpiresIn) * time.Second)
+	}
+	return tok, nil
+}
+
+func (c *OAuthConfig) authorizeURL() string {
+	if c.AuthorizeURL != "" {
+		return c.AuthorizeURL
+	}
+	return defaultAuthorizeURL
+}
+
+func (c *OAuthConfig) tokenURL() string {
+	if c.TokenURL != "" {
+		return c.TokenURL
+	}
+	return defaultTokenURL
+}
+
+func (c *OAuthConfig) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
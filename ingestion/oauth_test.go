@@ -0,0 +1,92 @@
+package ingestion
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OAuthConfig_AuthCodeURL(t *testing.T) {
+	cfg := &OAuthConfig{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []string{"candidates.create", "candidates.view"},
+	}
+
+	got, err := url.Parse(cfg.AuthCodeURL("xyz"))
+	assert.NoError(t, err)
+	assert.Equal(t, "app.greenhouse.io", got.Host)
+	assert.Equal(t, "/oauth/authorize", got.Path)
+
+	q := got.Query()
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "client-id", q.Get("client_id"))
+	assert.Equal(t, "https://example.com/callback", q.Get("redirect_uri"))
+	assert.Equal(t, "xyz", q.Get("state"))
+	assert.Equal(t, "candidates.create candidates.view", q.Get("scope"))
+}
+
+func Test_OAuthConfig_Exchange(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "authorization_code", r.FormValue("grant_type"))
+		assert.Equal(t, "auth-code", r.FormValue("code"))
+		w.WriteHeader(200)
+		io.WriteString(w, `{"access_token":"access-1","refresh_token":"refresh-1","token_type":"bearer","expires_in":3600}`)
+	}))
+	defer tokenServer.Close()
+
+	cfg := &OAuthConfig{ClientID: "client-id", ClientSecret: "secret", TokenURL: tokenServer.URL}
+	tok, err := cfg.Exchange(context.Background(), "auth-code")
+	assert.NoError(t, err)
+	assert.Equal(t, "access-1", tok.AccessToken)
+	assert.Equal(t, "refresh-1", tok.RefreshToken)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), tok.Expiry, 5*time.Second)
+}
+
+func Test_do_oauth_refreshesOn401(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var tokenRequests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.FormValue("grant_type"))
+		assert.Equal(t, "stale-refresh", r.FormValue("refresh_token"))
+		w.WriteHeader(200)
+		io.WriteString(w, `{"access_token":"fresh-access","refresh_token":"fresh-refresh","token_type":"bearer","expires_in":3600}`)
+	}))
+	defer tokenServer.Close()
+
+	var refreshedTokens []*Token
+	cfg := &OAuthConfig{ClientID: "client-id", ClientSecret: "secret", TokenURL: tokenServer.URL}
+	client = NewClientOAuth(cfg, &Token{AccessToken: "stale-access", RefreshToken: "stale-refresh", Expiry: time.Now().Add(time.Hour)}, nil,
+		WithTokenRefreshFunc(func(tok *Token) { refreshedTokens = append(refreshedTokens, tok) }),
+	)
+	client.baseURL = server.URL + "/"
+
+	var calls []string
+	mux.HandleFunc("/v1/partner/candidates", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Header.Get("Authorization"))
+		if r.Header.Get("Authorization") == "Bearer stale-access" {
+			w.WriteHeader(401)
+			return
+		}
+		w.WriteHeader(200)
+		io.WriteString(w, `[]`)
+	})
+
+	_, err := client.Candidates.Retrieve([]int64{12})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Bearer stale-access", "Bearer fresh-access"}, calls)
+	assert.Equal(t, 1, tokenRequests)
+	assert.Len(t, refreshedTokens, 1)
+	assert.Equal(t, "fresh-access", refreshedTokens[0].AccessToken)
+}
@@ -0,0 +1,130 @@
+package ingestion
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures the exponential backoff used by Client.do when it
+// encounters a transient failure: a network error, a 429 (rate limit), or a
+// 5xx server error. It follows the classic "exponential backoff with jitter"
+// algorithm: interval starts at InitialInterval, each retry sleeps for
+// interval randomized by ±RandomizationFactor, and then interval is grown
+// by Multiplier (capped at MaxInterval). Retrying stops once MaxElapsedTime
+// has passed or MaxRetries retries (after the initial attempt) have been
+// made, whichever comes first.
+type RetryPolicy struct {
+	InitialInterval     time.Duration
+	RandomizationFactor float64
+	Multiplier          float64
+	MaxInterval         time.Duration
+	MaxElapsedTime      time.Duration
+	MaxRetries          int
+}
+
+// DefaultRetryPolicy is used by NewClient and NewClientBasicAuth unless
+// overridden with WithRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	InitialInterval:     500 * time.Millisecond,
+	RandomizationFactor: 0.5,
+	Multiplier:          1.5,
+	MaxInterval:         60 * time.Second,
+	MaxElapsedTime:      15 * time.Minute,
+	MaxRetries:          5,
+}
+
+// WithRetryPolicy overrides the client's retry policy.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// Clock abstracts time.Now so tests can control the elapsed-time budget
+// deterministically.
+type Clock interface {
+	Now() time.Time
+}
+
+// Sleeper abstracts time.Sleep so tests can assert the sequence of sleeps
+// a retry loop performs without real time passing.
+type Sleeper interface {
+	Sleep(d time.Duration)
+}
+
+// WithClock overrides the clock used to track MaxElapsedTime. Intended for
+// tests; production callers should leave this unset.
+func WithClock(clock Clock) ClientOption {
+	return func(c *Client) {
+		c.clock = clock
+	}
+}
+
+// WithSleeper overrides the sleeper used between retries. Intended for
+// tests; production callers should leave this unset.
+func WithSleeper(sleeper Sleeper) ClientOption {
+	return func(c *Client) {
+		c.sleeper = sleeper
+	}
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+type realSleeper struct{}
+
+func (realSleeper) Sleep(d time.Duration) { time.Sleep(d) }
+
+// isRetriableStatus reports whether statusCode is one Client.do will retry:
+// 429 (rate limited) or a 5xx server error. Other 4xx statuses are treated
+// as permanent ClientErrors and are never retried.
+func isRetriableStatus(statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return statusCode >= 500
+}
+
+// nextBackoff returns the randomized sleep duration for the current
+// interval and advances *interval toward policy.MaxInterval.
+func nextBackoff(interval *time.Duration, policy RetryPolicy) time.Duration {
+	wait := randomizeInterval(*interval, policy.RandomizationFactor)
+
+	grown := time.Duration(float64(*interval) * policy.Multiplier)
+	if policy.MaxInterval > 0 && grown > policy.MaxInterval {
+		grown = policy.MaxInterval
+	}
+	*interval = grown
+
+	return wait
+}
+
+func randomizeInterval(interval time.Duration, randomizationFactor float64) time.Duration {
+	if randomizationFactor <= 0 {
+		return interval
+	}
+	delta := randomizationFactor * float64(interval)
+	min := float64(interval) - delta
+	max := float64(interval) + delta
+	return time.Duration(min + (rand.Float64() * (max - min + 1)))
+}
+
+// retryAfter returns the wait duration indicated by a response's
+// Retry-After header, or 0 if the header is absent or unparseable. A
+// Retry-After in seconds overrides the computed backoff interval.
+func retryAfter(header http.Header) time.Duration {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package ingestion
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_nextBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		InitialInterval:     100 * time.Millisecond,
+		RandomizationFactor: 0,
+		Multiplier:          2,
+		MaxInterval:         300 * time.Millisecond,
+	}
+
+	interval := policy.InitialInterval
+
+	wait := nextBackoff(&interval, policy)
+	assert.Equal(t, 100*time.Millisecond, wait)
+	assert.Equal(t, 200*time.Millisecond, interval)
+
+	wait = nextBackoff(&interval, policy)
+	assert.Equal(t, 200*time.Millisecond, wait)
+	assert.Equal(t, 300*time.Millisecond, interval, "interval growth must be capped at MaxInterval")
+
+	wait = nextBackoff(&interval, policy)
+	assert.Equal(t, 300*time.Millisecond, wait)
+	assert.Equal(t, 300*time.Millisecond, interval)
+}
+
+func Test_randomizeInterval(t *testing.T) {
+	interval := 100 * time.Millisecond
+	for i := 0; i < 50; i++ {
+		got := randomizeInterval(interval, 0.5)
+		assert.GreaterOrEqual(t, got, 50*time.Millisecond)
+		assert.LessOrEqual(t, got, 150*time.Millisecond)
+	}
+}
+
+func Test_isRetriableStatus(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		want       bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusUnauthorized, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, isRetriableStatus(tt.statusCode), "status %d", tt.statusCode)
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{
+			name:   "absent",
+			header: http.Header{},
+			want:   0,
+		},
+		{
+			name:   "seconds",
+			header: http.Header{"Retry-After": []string{"2"}},
+			want:   2 * time.Second,
+		},
+		{
+			name:   "unparseable",
+			header: http.Header{"Retry-After": []string{"not-a-duration"}},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, retryAfter(tt.header))
+		})
+	}
+}
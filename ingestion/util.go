@@ -0,0 +1,77 @@
+package ingestion
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// interfaceToCSV renders a slice (e.g. []int64 or []string) as a
+// comma-delimited string, suitable for a CSV-style query parameter such as
+// ids=1,2,3.
+func interfaceToCSV(a interface{}) string {
+	return delimit(a, ",")
+}
+
+// spaceDelimit renders a slice as a space-delimited string, suitable for a
+// query parameter such as scopes like "candidates.create candidates.view".
+func spaceDelimit(a interface{}) string {
+	return delimit(a, " ")
+}
+
+func delimit(a interface{}, sep string) string {
+	v := reflect.ValueOf(a)
+	parts := make([]string, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		parts[i] = fmt.Sprintf("%v", v.Index(i).Interface())
+	}
+	return strings.Join(parts, sep)
+}
+
+// formatReadCloser reads rc to completion and returns its contents as a
+// string, restoring rc so it can still be read normally afterward (request
+// bodies can otherwise only be read once).
+func formatReadCloser(rc *io.ReadCloser) string {
+	body, err := io.ReadAll(*rc)
+	if err != nil {
+		return ""
+	}
+	*rc = io.NopCloser(bytes.NewReader(body))
+	return string(body)
+}
+
+// parseLinkHeader parses an RFC 5988 Link header value (e.g. `<url1>;
+// rel="next", <url2>; rel="last"`) into a map of rel to URL. Entries that
+// don't look like "<url>; rel=..." are skipped rather than erroring, since
+// a malformed or missing Link header should simply look like "no more
+// pages" to callers.
+func parseLinkHeader(value string) map[string]string {
+	links := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		segments := strings.Split(entry, ";")
+		if len(segments) < 2 {
+			continue
+		}
+
+		urlPart := strings.TrimSpace(segments[0])
+		if !strings.HasPrefix(urlPart, "<") || !strings.HasSuffix(urlPart, ">") {
+			continue
+		}
+		linkURL := urlPart[1 : len(urlPart)-1]
+
+		for _, param := range segments[1:] {
+			param = strings.TrimSpace(param)
+			rel, found := strings.CutPrefix(param, "rel=")
+			if !found {
+				continue
+			}
+			rel = strings.Trim(rel, `"`)
+			if rel != "" {
+				links[rel] = linkURL
+			}
+		}
+	}
+	return links
+}